@@ -0,0 +1,245 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ClustersService is a thin facade over GroupClustersService,
+// ProjectClustersService and InstanceClustersService that lets callers
+// iterate clusters across all three scopes without picking the right
+// service and paging manually.
+type ClustersService struct {
+	client *Client
+}
+
+// ClusterScope identifies which GitLab API a Cluster was registered
+// against.
+type ClusterScope string
+
+// Available ClusterScope values.
+const (
+	ScopeGroup    ClusterScope = "group"
+	ScopeProject  ClusterScope = "project"
+	ScopeInstance ClusterScope = "instance"
+)
+
+// Cluster is implemented by GroupCluster, ProjectCluster and
+// InstanceCluster so callers can treat clusters from any scope uniformly.
+type Cluster interface {
+	GetID() int
+	GetName() string
+	GetProviderType() string
+	GetEnvironmentScope() string
+	GetAPIURL() string
+}
+
+func (c *GroupCluster) GetID() int                  { return c.ID }
+func (c *GroupCluster) GetName() string             { return c.Name }
+func (c *GroupCluster) GetProviderType() string     { return c.ProviderType }
+func (c *GroupCluster) GetEnvironmentScope() string { return c.EnvironmentScope }
+func (c *GroupCluster) GetAPIURL() string {
+	if c.PlatformKubernetes == nil {
+		return ""
+	}
+	return c.PlatformKubernetes.APIURL
+}
+
+func (c *ProjectCluster) GetID() int                  { return c.ID }
+func (c *ProjectCluster) GetName() string             { return c.Name }
+func (c *ProjectCluster) GetProviderType() string     { return c.ProviderType }
+func (c *ProjectCluster) GetEnvironmentScope() string { return c.EnvironmentScope }
+func (c *ProjectCluster) GetAPIURL() string {
+	if c.PlatformKubernetes == nil {
+		return ""
+	}
+	return c.PlatformKubernetes.APIURL
+}
+
+func (c *InstanceCluster) GetID() int                  { return c.ID }
+func (c *InstanceCluster) GetName() string             { return c.Name }
+func (c *InstanceCluster) GetProviderType() string     { return c.ProviderType }
+func (c *InstanceCluster) GetEnvironmentScope() string { return c.EnvironmentScope }
+func (c *InstanceCluster) GetAPIURL() string {
+	if c.PlatformKubernetes == nil {
+		return ""
+	}
+	return c.PlatformKubernetes.APIURL
+}
+
+// NormalizedCluster is the scope-agnostic view a ClusterIterator yields.
+type NormalizedCluster struct {
+	ID               int
+	Name             string
+	ProviderType     string
+	EnvironmentScope string
+	APIURL           string
+	Scope            ClusterScope
+	OwnerRef         interface{}
+	Cluster          Cluster
+}
+
+// ClusterFilter selects which clusters ClustersService.All and
+// ClustersService.ForEach iterate over.
+type ClusterFilter struct {
+	// Scope is required and selects ProjectClusters, GroupClusters or
+	// InstanceClusters as the backing service.
+	Scope ClusterScope
+
+	// OwnerID is the group or project ID the clusters belong to. It's
+	// ignored (and may be left nil) when Scope is ScopeInstance.
+	OwnerID interface{}
+}
+
+// All returns an iterator over the clusters matching filter. The iterator
+// makes a single list request to the underlying group/project/instance
+// cluster endpoint and yields every cluster in that response; it does not
+// request further pages.
+func (s *ClustersService) All(ctx context.Context, filter ClusterFilter) *ClusterIterator {
+	return &ClusterIterator{
+		ctx:    ctx,
+		client: s.client,
+		filter: filter,
+	}
+}
+
+// ForEach calls fn for every cluster matching filter, stopping at the first
+// error returned by fn or encountered while paging. It backs off and
+// retries when the API responds with a rate limit error, using the
+// Retry-After header on the underlying Response when present.
+func (s *ClustersService) ForEach(ctx context.Context, filter ClusterFilter, fn func(Cluster) error) error {
+	it := s.All(ctx, filter)
+	for it.Next() {
+		if err := fn(it.Cluster().Cluster); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// ClusterIterator walks a group, project or instance cluster listing,
+// normalizing every entry into a NormalizedCluster.
+type ClusterIterator struct {
+	ctx    context.Context
+	client *Client
+	filter ClusterFilter
+
+	buf     []*NormalizedCluster
+	current *NormalizedCluster
+	done    bool
+	err     error
+}
+
+// Next advances the iterator and reports whether a cluster is available via
+// Cluster. It returns false once the listing is exhausted or an error
+// occurred; check Err to distinguish the two.
+func (it *ClusterIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for len(it.buf) == 0 {
+		if it.done {
+			return false
+		}
+		if err := it.fetch(); err != nil {
+			it.err = err
+			return false
+		}
+	}
+	it.current, it.buf = it.buf[0], it.buf[1:]
+	return true
+}
+
+// Cluster returns the NormalizedCluster produced by the most recent call to
+// Next.
+func (it *ClusterIterator) Cluster() *NormalizedCluster {
+	return it.current
+}
+
+// Err returns the error, if any, that stopped iteration.
+func (it *ClusterIterator) Err() error {
+	return it.err
+}
+
+func (it *ClusterIterator) fetch() error {
+	opt := []OptionFunc{WithContext(it.ctx)}
+
+	var (
+		normalized []*NormalizedCluster
+		resp       *Response
+		err        error
+	)
+
+	switch it.filter.Scope {
+	case ScopeGroup:
+		var clusters []*GroupCluster
+		clusters, resp, err = it.client.GroupClusters.ListClusters(it.filter.OwnerID, opt...)
+		for _, c := range clusters {
+			normalized = append(normalized, normalizeCluster(c, ScopeGroup, it.filter.OwnerID))
+		}
+	case ScopeProject:
+		var clusters []*ProjectCluster
+		clusters, resp, err = it.client.ProjectClusters.ListClusters(it.filter.OwnerID, opt...)
+		for _, c := range clusters {
+			normalized = append(normalized, normalizeCluster(c, ScopeProject, it.filter.OwnerID))
+		}
+	case ScopeInstance:
+		var clusters []*InstanceCluster
+		clusters, resp, err = it.client.InstanceClusters.ListClusters(opt...)
+		for _, c := range clusters {
+			normalized = append(normalized, normalizeCluster(c, ScopeInstance, nil))
+		}
+	default:
+		return fmt.Errorf("gitlab: invalid ClusterFilter.Scope %q", it.filter.Scope)
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 429 {
+			select {
+			case <-time.After(retryAfter(resp)):
+				return nil
+			case <-it.ctx.Done():
+				return it.ctx.Err()
+			}
+		}
+		return err
+	}
+
+	it.buf = append(it.buf, normalized...)
+
+	// The group/project/instance cluster listing endpoints return every
+	// cluster in a single response today, so one fetch always exhausts
+	// the iterator.
+	it.done = true
+	return nil
+}
+
+func normalizeCluster(c Cluster, scope ClusterScope, ownerID interface{}) *NormalizedCluster {
+	return &NormalizedCluster{
+		ID:               c.GetID(),
+		Name:             c.GetName(),
+		ProviderType:     c.GetProviderType(),
+		EnvironmentScope: c.GetEnvironmentScope(),
+		APIURL:           c.GetAPIURL(),
+		Scope:            scope,
+		OwnerRef:         ownerID,
+		Cluster:          c,
+	}
+}
+
+// retryAfter returns how long to wait before retrying a rate-limited
+// request, falling back to a short default when the response carries no
+// Retry-After header.
+func retryAfter(resp *Response) time.Duration {
+	if resp == nil || resp.Response == nil {
+		return time.Second
+	}
+	if s := resp.Header.Get("Retry-After"); s != "" {
+		if secs, err := strconv.Atoi(s); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	return time.Second
+}
@@ -0,0 +1,59 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClusterIteratorGroupScope(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1234/clusters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"name":"cluster-1","provider_type":"user","environment_scope":"*","platform_kubernetes":{"api_url":"https://1.2.3.4"}}]`)
+	})
+
+	it := client.Clusters.All(context.Background(), ClusterFilter{Scope: ScopeGroup, OwnerID: 1234})
+
+	var got []*NormalizedCluster
+	for it.Next() {
+		got = append(got, it.Cluster())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("ClusterIterator.Err returned %v", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("expected 1 cluster; got %d", len(got))
+	}
+
+	if got[0].ID != 1 || got[0].APIURL != "https://1.2.3.4" || got[0].Scope != ScopeGroup {
+		t.Errorf("unexpected normalized cluster: %+v", got[0])
+	}
+}
+
+func TestClusterIteratorInstanceScope(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/clusters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":2,"name":"cluster-2","provider_type":"user","environment_scope":"*","platform_kubernetes":{"api_url":"https://5.6.7.8"}}]`)
+	})
+
+	var visited []int
+	err := client.Clusters.ForEach(context.Background(), ClusterFilter{Scope: ScopeInstance}, func(c Cluster) error {
+		visited = append(visited, c.GetID())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ClustersService.ForEach returned error: %v", err)
+	}
+
+	if len(visited) != 1 || visited[0] != 2 {
+		t.Errorf("expected to visit cluster 2; got %v", visited)
+	}
+}
@@ -0,0 +1,232 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// ClusterApplicationStatus represents the lifecycle state of a cluster
+// application as reported by the cluster-applications endpoints.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_clusters.html
+type ClusterApplicationStatus string
+
+// Available ClusterApplicationStatus values.
+const (
+	ClusterApplicationInstallable      ClusterApplicationStatus = "installable"
+	ClusterApplicationScheduled        ClusterApplicationStatus = "scheduled"
+	ClusterApplicationInstalling       ClusterApplicationStatus = "installing"
+	ClusterApplicationInstalled        ClusterApplicationStatus = "installed"
+	ClusterApplicationErrored          ClusterApplicationStatus = "errored"
+	ClusterApplicationUpdating         ClusterApplicationStatus = "updating"
+	ClusterApplicationUninstalling     ClusterApplicationStatus = "uninstalling"
+	ClusterApplicationUninstallErrored ClusterApplicationStatus = "uninstall_errored"
+	ClusterApplicationUninstalled      ClusterApplicationStatus = "uninstalled"
+)
+
+// ClusterApplication represents the install state of a single application
+// on a group or project cluster.
+type ClusterApplication struct {
+	Name         string                   `json:"name"`
+	Status       ClusterApplicationStatus `json:"status"`
+	StatusReason string                   `json:"status_reason"`
+	Version      string                   `json:"version,omitempty"`
+}
+
+// InstallApplicationOptions represents the available options across the
+// cluster applications GitLab currently supports. Only the fields relevant
+// to the application being installed need to be set; the rest are omitted
+// from the request.
+type InstallApplicationOptions struct {
+	Hostname             *string `url:"hostname,omitempty" json:"hostname,omitempty"`
+	Email                *string `url:"email,omitempty" json:"email,omitempty"`
+	ModsecurityEnabled   *bool   `url:"modsecurity_enabled,omitempty" json:"modsecurity_enabled,omitempty"`
+	StackdriverProjectID *string `url:"stackdriver_project_id,omitempty" json:"stackdriver_project_id,omitempty"`
+}
+
+// ApplicationSpec names one application InstallBundle should install, along
+// with its install options.
+type ApplicationSpec struct {
+	Name    string
+	Options *InstallApplicationOptions
+}
+
+// ApplicationInstallError reports that one application out of an
+// InstallBundle call did not reach the installed state.
+type ApplicationInstallError struct {
+	Name         string
+	Status       ClusterApplicationStatus
+	StatusReason string
+}
+
+func (e *ApplicationInstallError) Error() string {
+	return fmt.Sprintf("gitlab: application %q ended in status %q: %s", e.Name, e.Status, e.StatusReason)
+}
+
+// ListApplications returns the install status of every application GitLab
+// knows about for the given group cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_clusters.html#get-a-single-group-cluster
+func (s *GroupClustersService) ListApplications(gid interface{}, cluster int, options ...OptionFunc) ([]*ClusterApplication, *Response, error) {
+	gc, resp, err := s.GetCluster(gid, cluster, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gc.Applications, resp, nil
+}
+
+// InstallApplication schedules the named application for install on the
+// given group cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_clusters.html
+func (s *GroupClustersService) InstallApplication(gid interface{}, cluster int, application string, opt *InstallApplicationOptions, options ...OptionFunc) (*ClusterApplication, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/clusters/%d/applications/%s", pathEscape(group), cluster, application)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := new(ClusterApplication)
+	resp, err := s.client.Do(req, app)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return app, resp, err
+}
+
+// UpdateApplication updates the named application on the given group
+// cluster, for example to roll it onto a new chart version.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_clusters.html
+func (s *GroupClustersService) UpdateApplication(gid interface{}, cluster int, application string, opt *InstallApplicationOptions, options ...OptionFunc) (*ClusterApplication, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/clusters/%d/applications/%s", pathEscape(group), cluster, application)
+
+	req, err := s.client.NewRequest("PATCH", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := new(ClusterApplication)
+	resp, err := s.client.Do(req, app)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return app, resp, err
+}
+
+// UninstallApplication schedules the named application for removal from the
+// given group cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_clusters.html
+func (s *GroupClustersService) UninstallApplication(gid interface{}, cluster int, application string, options ...OptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/clusters/%d/applications/%s", pathEscape(group), cluster, application)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// applicationInstallOrder puts "helm" first, since every other application
+// depends on Tiller/Helm being present before it can install, and leaves
+// everything else in the order the caller supplied.
+func applicationInstallOrder(apps []ApplicationSpec) []ApplicationSpec {
+	ordered := make([]ApplicationSpec, 0, len(apps))
+	for _, app := range apps {
+		if app.Name == "helm" {
+			ordered = append(ordered, app)
+		}
+	}
+	for _, app := range apps {
+		if app.Name != "helm" {
+			ordered = append(ordered, app)
+		}
+	}
+	return ordered
+}
+
+// InstallBundle installs every application in apps onto the given group
+// cluster, installing "helm" first when present since the rest depend on
+// it, then polls each application's status until it reaches installed or
+// errored. It returns the final ClusterApplication for every app in apps. If
+// one or more ended up errored, the first such failure is returned as a
+// *ApplicationInstallError; the full set of results is still returned
+// alongside it so callers can inspect every application's outcome.
+func (s *GroupClustersService) InstallBundle(gid interface{}, cluster int, apps []ApplicationSpec, options ...OptionFunc) ([]*ClusterApplication, error) {
+	const (
+		pollInterval = 5 * time.Second
+		pollTimeout  = 10 * time.Minute
+	)
+
+	ordered := applicationInstallOrder(apps)
+	results := make(map[string]*ClusterApplication, len(ordered))
+
+	for _, spec := range ordered {
+		if _, _, err := s.InstallApplication(gid, cluster, spec.Name, spec.Options, options...); err != nil {
+			return nil, fmt.Errorf("gitlab: installing application %q: %w", spec.Name, err)
+		}
+
+		deadline := time.Now().Add(pollTimeout)
+		for {
+			apps, _, err := s.ListApplications(gid, cluster, options...)
+			if err != nil {
+				return nil, fmt.Errorf("gitlab: polling application %q: %w", spec.Name, err)
+			}
+
+			app := findApplication(apps, spec.Name)
+			if app != nil && (app.Status == ClusterApplicationInstalled || app.Status == ClusterApplicationErrored) {
+				results[spec.Name] = app
+				break
+			}
+
+			if time.Now().After(deadline) {
+				return nil, fmt.Errorf("gitlab: timed out waiting for application %q to finish installing", spec.Name)
+			}
+			time.Sleep(pollInterval)
+		}
+	}
+
+	out := make([]*ClusterApplication, 0, len(ordered))
+	var firstErr error
+	for _, spec := range ordered {
+		app := results[spec.Name]
+		out = append(out, app)
+		if app.Status == ClusterApplicationErrored && firstErr == nil {
+			firstErr = &ApplicationInstallError{Name: app.Name, Status: app.Status, StatusReason: app.StatusReason}
+		}
+	}
+
+	return out, firstErr
+}
+
+func findApplication(apps []*ClusterApplication, name string) *ClusterApplication {
+	for _, app := range apps {
+		if app.Name == name {
+			return app
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListGroupClusterApplications(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1234/clusters/18", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":18,"name":"cluster-1","applications":[{"name":"helm","status":"installed"},{"name":"ingress","status":"installable"}]}`)
+	})
+
+	apps, _, err := client.GroupClusters.ListApplications(1234, 18)
+	if err != nil {
+		t.Fatalf("GroupClusters.ListApplications returned error: %v", err)
+	}
+
+	if len(apps) != 2 {
+		t.Fatalf("expected 2 applications; got %d", len(apps))
+	}
+
+	if apps[0].Name != "helm" || apps[0].Status != ClusterApplicationInstalled {
+		t.Errorf("unexpected first application: %+v", apps[0])
+	}
+}
+
+func TestInstallGroupClusterApplication(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1234/clusters/18/applications/cert-manager", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"name":"cert-manager","status":"scheduled"}`)
+	})
+
+	app, _, err := client.GroupClusters.InstallApplication(1234, 18, "cert-manager", &InstallApplicationOptions{
+		Email: String("ops@example.com"),
+	})
+	if err != nil {
+		t.Fatalf("GroupClusters.InstallApplication returned error: %v", err)
+	}
+
+	if app.Status != ClusterApplicationScheduled {
+		t.Errorf("expected status scheduled; got %q", app.Status)
+	}
+}
+
+func TestUninstallGroupClusterApplication(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1234/clusters/18/applications/helm", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	resp, err := client.GroupClusters.UninstallApplication(1234, 18, "helm")
+	if err != nil {
+		t.Fatalf("GroupClusters.UninstallApplication returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("expected status 202; got %d", resp.StatusCode)
+	}
+}
+
+func TestInstallBundleOrdersHelmFirst(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	var installOrder []string
+
+	mux.HandleFunc("/api/v4/groups/1234/clusters/18", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":18,"applications":[{"name":"helm","status":"installed"},{"name":"ingress","status":"installed"}]}`)
+	})
+	mux.HandleFunc("/api/v4/groups/1234/clusters/18/applications/helm", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		installOrder = append(installOrder, "helm")
+		fmt.Fprint(w, `{"name":"helm","status":"installed"}`)
+	})
+	mux.HandleFunc("/api/v4/groups/1234/clusters/18/applications/ingress", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		installOrder = append(installOrder, "ingress")
+		fmt.Fprint(w, `{"name":"ingress","status":"installed"}`)
+	})
+
+	apps, err := client.GroupClusters.InstallBundle(1234, 18, []ApplicationSpec{
+		{Name: "ingress"},
+		{Name: "helm"},
+	})
+	if err != nil {
+		t.Fatalf("GroupClusters.InstallBundle returned error: %v", err)
+	}
+
+	if len(installOrder) != 2 || installOrder[0] != "helm" {
+		t.Errorf("expected helm to install first; got order %v", installOrder)
+	}
+
+	if len(apps) != 2 {
+		t.Fatalf("expected 2 results; got %d", len(apps))
+	}
+}
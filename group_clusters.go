@@ -2,6 +2,7 @@ package gitlab
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -29,6 +30,16 @@ type GroupCluster struct {
 	User               *User                    `json:"user"`
 	PlatformKubernetes *GroupPlatformKubernetes `json:"platform_kubernetes"`
 	Group              *Group                   `json:"group"`
+	Applications       []*ClusterApplication    `json:"applications"`
+	Labels             map[string]string        `json:"labels"`
+	KVPairs            []ClusterKV              `json:"kv_pairs"`
+}
+
+// ClusterKV is a free-form key/value pair attached to a cluster, in
+// addition to its structured Labels.
+type ClusterKV struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
 }
 
 // PlatformKubernetes represents a GitLab Group Cluster PlatformKubernetes.
@@ -43,6 +54,25 @@ func (v GroupCluster) String() string {
 	return Stringify(v)
 }
 
+// ListGroupClustersOptions represents the available ListClusters() options.
+//
+// LabelSelector is serialized as a comma-separated list of key=value (or
+// key!=value to negate) requirements, e.g. "tier=prod,region!=eu".
+type ListGroupClustersOptions struct {
+	LabelSelector    *string `url:"label_selector,omitempty" json:"label_selector,omitempty"`
+	EnvironmentScope *string `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
+	ProviderType     *string `url:"provider_type,omitempty" json:"provider_type,omitempty"`
+}
+
+// WithLabelSelector builds a ListGroupClustersOptions that filters by the
+// given label selector, for use as ListClustersWithOptions' opt argument,
+// e.g.
+//
+//	clusters, _, _ := client.GroupClusters.ListClustersWithOptions(gid, WithLabelSelector("tier=prod,region=eu"))
+func WithLabelSelector(selector string) *ListGroupClustersOptions {
+	return &ListGroupClustersOptions{LabelSelector: String(selector)}
+}
+
 // ListClusters gets a list of all clusters in a group.
 //
 // GitLab API docs:
@@ -68,6 +98,118 @@ func (s *GroupClustersService) ListClusters(gid interface{}, options ...OptionFu
 	return pcs, resp, err
 }
 
+// ListClustersWithOptions gets a list of clusters in a group narrowed down
+// by opt, e.g. by label selector. It's a separate method from ListClusters
+// so that existing ListClusters callers aren't forced to pass an opt they
+// don't need.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_clusters.html#list-group-clusters
+func (s *GroupClustersService) ListClustersWithOptions(gid interface{}, opt *ListGroupClustersOptions, options ...OptionFunc) ([]*GroupCluster, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/clusters", pathEscape(group))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pcs []*GroupCluster
+	resp, err := s.client.Do(req, &pcs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if opt != nil && opt.LabelSelector != nil {
+		pcs, err = MatchLabels(pcs, *opt.LabelSelector)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return pcs, resp, err
+}
+
+// MatchLabels filters clusters down to those whose Labels satisfy selector,
+// a comma-separated list of key=value (match) or key!=value (negated
+// match) requirements. It's applied client-side so callers still get
+// accurate filtering against a GitLab instance that ignores
+// LabelSelector. It returns an error if selector is malformed, rather than
+// silently returning clusters unfiltered.
+func MatchLabels(clusters []*GroupCluster, selector string) ([]*GroupCluster, error) {
+	reqs, err := parseLabelSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	if len(reqs) == 0 {
+		return clusters, nil
+	}
+
+	var matched []*GroupCluster
+	for _, c := range clusters {
+		if labelsSatisfy(c.Labels, reqs) {
+			matched = append(matched, c)
+		}
+	}
+	return matched, nil
+}
+
+type labelRequirement struct {
+	key     string
+	value   string
+	negated bool
+}
+
+func parseLabelSelector(selector string) ([]labelRequirement, error) {
+	selector = strings.TrimSpace(selector)
+	if selector == "" {
+		return nil, nil
+	}
+
+	var reqs []labelRequirement
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		negated := false
+		sep := "="
+		if strings.Contains(term, "!=") {
+			negated = true
+			sep = "!="
+		}
+
+		parts := strings.SplitN(term, sep, 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("gitlab: invalid label selector term %q", term)
+		}
+
+		reqs = append(reqs, labelRequirement{
+			key:     strings.TrimSpace(parts[0]),
+			value:   strings.TrimSpace(parts[1]),
+			negated: negated,
+		})
+	}
+	return reqs, nil
+}
+
+func labelsSatisfy(labels map[string]string, reqs []labelRequirement) bool {
+	for _, req := range reqs {
+		value, ok := labels[req.key]
+		switch {
+		case req.negated && ok && value == req.value:
+			return false
+		case !req.negated && (!ok || value != req.value):
+			return false
+		}
+	}
+	return true
+}
+
 // GetCluster gets a cluster.
 //
 // GitLab API docs:
@@ -104,6 +246,8 @@ type AddGroupClusterOptions struct {
 	Managed            *bool                              `url:"managed,omitempty" json:"managed,omitempty"`
 	EnvironmentScope   *string                            `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
 	PlatformKubernetes *AddGroupPlatformKubernetesOptions `url:"platform_kubernetes_attributes,omitempty" json:"platform_kubernetes_attributes,omitempty"`
+	Labels             map[string]string                  `url:"labels,omitempty" json:"labels,omitempty"`
+	KVPairs            []ClusterKV                        `url:"kv_pairs,omitempty" json:"kv_pairs,omitempty"`
 }
 
 // AddGroupPlatformKubernetesOptions represents the available PlatformKubernetes options for adding a Group Cluster.
@@ -144,17 +288,19 @@ func (s *GroupClustersService) AddCluster(gid interface{}, opt *AddGroupClusterO
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_clusters.html#edit-group-cluster
 type EditGroupClusterOptions struct {
-	Name               *string                        `url:"name,omitempty" json:"name,omitempty"`
-	Domain             *string                        `url:"domain,omitempty" json:"domain,omitempty"`
-	EnvironmentScope   *string                        `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
+	Name               *string                             `url:"name,omitempty" json:"name,omitempty"`
+	Domain             *string                             `url:"domain,omitempty" json:"domain,omitempty"`
+	EnvironmentScope   *string                             `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
 	PlatformKubernetes *EditGroupPlatformKubernetesOptions `url:"platform_kubernetes_attributes,omitempty" json:"platform_kubernetes_attributes,omitempty"`
+	Labels             map[string]string                   `url:"labels,omitempty" json:"labels,omitempty"`
+	KVPairs            []ClusterKV                         `url:"kv_pairs,omitempty" json:"kv_pairs,omitempty"`
 }
 
 // EditGroupPlatformKubernetesOptions represents the available PlatformKubernetes options for editing a Group Cluster.
 type EditGroupPlatformKubernetesOptions struct {
-	APIURL    *string `url:"api_url,omitempty" json:"api_url,omitempty"`
-	Token     *string `url:"token,omitempty" json:"token,omitempty"`
-	CaCert    *string `url:"ca_cert,omitempty" json:"ca_cert,omitempty"`
+	APIURL *string `url:"api_url,omitempty" json:"api_url,omitempty"`
+	Token  *string `url:"token,omitempty" json:"token,omitempty"`
+	CaCert *string `url:"ca_cert,omitempty" json:"ca_cert,omitempty"`
 }
 
 // EditCluster updates an existing group cluster.
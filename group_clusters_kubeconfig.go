@@ -0,0 +1,295 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// Errors returned while deriving a cluster registration from a kubeconfig.
+// Use errors.Is to check for these, as they're usually wrapped with the
+// offending context/cluster name.
+var (
+	ErrKubeconfigContextNotFound = errors.New("gitlab: kubeconfig context not found")
+	ErrKubeconfigNoCAData        = errors.New("gitlab: kubeconfig has no certificate authority data")
+	ErrKubeconfigNoToken         = errors.New("gitlab: kubeconfig auth-info has no token and none could be created")
+)
+
+// AddClusterFromKubeconfigOptions holds the extra, GitLab-specific knobs for
+// AddClusterFromKubeconfig and EditClusterFromKubeconfig that have no
+// equivalent in a kubeconfig file.
+type AddClusterFromKubeconfigOptions struct {
+	// AuthorizationType must be "rbac", "abac" or "unknown". Defaults to
+	// "rbac". AddClusterFromKubeconfig sends it as-is on the
+	// platform_kubernetes attributes; EditClusterFromKubeconfig validates it
+	// but does not send it.
+	AuthorizationType string
+
+	// SkipServiceAccountCreation disables minting a ServiceAccount and
+	// ClusterRoleBinding on the target cluster when the selected
+	// auth-info has no bearer token of its own. Set this when the
+	// kubeconfig already carries a long-lived token GitLab can use.
+	SkipServiceAccountCreation bool
+
+	// ServiceAccountNamespace is the namespace the bootstrap
+	// ServiceAccount is created in when a token must be minted. Defaults
+	// to "default".
+	ServiceAccountNamespace string
+
+	// ServiceAccountName is the name given to the bootstrap
+	// ServiceAccount. Defaults to "gitlab".
+	ServiceAccountName string
+}
+
+func (o *AddClusterFromKubeconfigOptions) withDefaults() *AddClusterFromKubeconfigOptions {
+	out := AddClusterFromKubeconfigOptions{}
+	if o != nil {
+		out = *o
+	}
+	if out.AuthorizationType == "" {
+		out.AuthorizationType = "rbac"
+	}
+	if out.ServiceAccountNamespace == "" {
+		out.ServiceAccountNamespace = "default"
+	}
+	if out.ServiceAccountName == "" {
+		out.ServiceAccountName = "gitlab"
+	}
+	return &out
+}
+
+func validAuthorizationType(t string) bool {
+	switch t {
+	case "rbac", "abac", "unknown":
+		return true
+	default:
+		return false
+	}
+}
+
+// resolvedKubernetesPlatform is the set of values every cluster-registration
+// endpoint needs, regardless of whether it ends up in an Add or Edit call.
+type resolvedKubernetesPlatform struct {
+	apiURL            string
+	caCert            string
+	token             string
+	authorizationType string
+}
+
+// resolveKubernetesPlatform parses kubeconfig, selects contextName (or the
+// current context when empty) and resolves everything GitLab needs to
+// register the cluster: the API URL, the CA certificate and a bearer token.
+// When the selected auth-info carries no token, it mints one by creating a
+// ServiceAccount and a cluster-admin ClusterRoleBinding on the target
+// cluster, unless opt.SkipServiceAccountCreation is set.
+func resolveKubernetesPlatform(kubeconfig []byte, contextName string, opt *AddClusterFromKubeconfigOptions) (*resolvedKubernetesPlatform, error) {
+	opt = opt.withDefaults()
+	if !validAuthorizationType(opt.AuthorizationType) {
+		return nil, fmt.Errorf("gitlab: invalid authorization_type %q, must be rbac, abac or unknown", opt.AuthorizationType)
+	}
+
+	cfg, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: parsing kubeconfig: %w", err)
+	}
+
+	ctxName := contextName
+	if ctxName == "" {
+		ctxName = cfg.CurrentContext
+	}
+	kctx, ok := cfg.Contexts[ctxName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrKubeconfigContextNotFound, ctxName)
+	}
+
+	cluster, ok := cfg.Clusters[kctx.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("%w: cluster %q referenced by context %q", ErrKubeconfigContextNotFound, kctx.Cluster, ctxName)
+	}
+	authInfo := cfg.AuthInfos[kctx.AuthInfo]
+
+	caData := cluster.CertificateAuthorityData
+	if len(caData) == 0 && cluster.CertificateAuthority != "" {
+		data, err := os.ReadFile(cluster.CertificateAuthority)
+		if err != nil {
+			return nil, fmt.Errorf("%w: reading %s: %v", ErrKubeconfigNoCAData, cluster.CertificateAuthority, err)
+		}
+		caData = data
+	}
+	if len(caData) == 0 {
+		return nil, ErrKubeconfigNoCAData
+	}
+
+	token := ""
+	if authInfo != nil {
+		token = authInfo.Token
+	}
+	if token == "" {
+		if opt.SkipServiceAccountCreation {
+			return nil, ErrKubeconfigNoToken
+		}
+		token, err = mintServiceAccountToken(cluster.Server, caData, authInfo, opt)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrKubeconfigNoToken, err)
+		}
+	}
+
+	return &resolvedKubernetesPlatform{
+		apiURL:            cluster.Server,
+		caCert:            string(caData),
+		token:             token,
+		authorizationType: opt.AuthorizationType,
+	}, nil
+}
+
+// mintServiceAccountToken authenticates to the cluster with the kubeconfig's
+// own credentials and creates a ServiceAccount bound to cluster-admin via a
+// ClusterRoleBinding, returning a token for that ServiceAccount.
+func mintServiceAccountToken(server string, caData []byte, authInfo *clientcmdapi.AuthInfo, opt *AddClusterFromKubeconfigOptions) (string, error) {
+	restConfig := &rest.Config{
+		Host: server,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData: caData,
+		},
+	}
+	if authInfo != nil {
+		certData, err := certOrKeyData(authInfo.ClientCertificateData, authInfo.ClientCertificate)
+		if err != nil {
+			return "", fmt.Errorf("reading client certificate: %w", err)
+		}
+		keyData, err := certOrKeyData(authInfo.ClientKeyData, authInfo.ClientKey)
+		if err != nil {
+			return "", fmt.Errorf("reading client key: %w", err)
+		}
+		restConfig.TLSClientConfig.CertData = certData
+		restConfig.TLSClientConfig.KeyData = keyData
+		restConfig.Username = authInfo.Username
+		restConfig.Password = authInfo.Password
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return "", fmt.Errorf("building in-cluster client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opt.ServiceAccountName,
+			Namespace: opt.ServiceAccountNamespace,
+		},
+	}
+	sa, err = clientset.CoreV1().ServiceAccounts(opt.ServiceAccountNamespace).Create(ctx, sa, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("creating service account: %w", err)
+	}
+
+	crb := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s-%s", opt.ServiceAccountName, opt.ServiceAccountNamespace),
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     "cluster-admin",
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      opt.ServiceAccountName,
+				Namespace: opt.ServiceAccountNamespace,
+			},
+		},
+	}
+	if _, err := clientset.RbacV1().ClusterRoleBindings().Create(ctx, crb, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("creating cluster role binding: %w", err)
+	}
+
+	tr, err := clientset.CoreV1().ServiceAccounts(opt.ServiceAccountNamespace).CreateToken(ctx, opt.ServiceAccountName, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: int64Ptr(31536000),
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("requesting service account token: %w", err)
+	}
+
+	_ = sa
+	return tr.Status.Token, nil
+}
+
+func int64Ptr(v int64) *int64 { return &v }
+
+// certOrKeyData returns embedded, falling back to reading it from path when
+// embedded is empty, the same file-path fallback resolveKubernetesPlatform
+// applies to the cluster's certificate authority.
+func certOrKeyData(embedded []byte, path string) ([]byte, error) {
+	if len(embedded) > 0 || path == "" {
+		return embedded, nil
+	}
+	return os.ReadFile(path)
+}
+
+// AddClusterFromKubeconfig registers a cluster with the group using the
+// named context from kubeconfig (or its current-context when contextName is
+// empty), resolving api_url, ca_cert and a bearer token without requiring
+// the caller to extract them by hand.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_clusters.html#add-existing-cluster-to-group
+func (s *GroupClustersService) AddClusterFromKubeconfig(gid interface{}, kubeconfig []byte, contextName string, opt *AddGroupClusterOptions, kubeOpt *AddClusterFromKubeconfigOptions, options ...OptionFunc) (*GroupCluster, *Response, error) {
+	platform, err := resolveKubernetesPlatform(kubeconfig, contextName, kubeOpt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opt == nil {
+		opt = &AddGroupClusterOptions{}
+	}
+	opt.PlatformKubernetes = &AddGroupPlatformKubernetesOptions{
+		APIURL:            String(platform.apiURL),
+		Token:             String(platform.token),
+		CaCert:            String(platform.caCert),
+		AuthorizationType: String(platform.authorizationType),
+	}
+
+	return s.AddCluster(gid, opt, options...)
+}
+
+// EditClusterFromKubeconfig updates an existing group cluster's connection
+// details from the named context in kubeconfig (or its current-context when
+// contextName is empty).
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_clusters.html#edit-group-cluster
+func (s *GroupClustersService) EditClusterFromKubeconfig(gid interface{}, cluster int, kubeconfig []byte, contextName string, opt *EditGroupClusterOptions, kubeOpt *AddClusterFromKubeconfigOptions, options ...OptionFunc) (*GroupCluster, *Response, error) {
+	platform, err := resolveKubernetesPlatform(kubeconfig, contextName, kubeOpt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opt == nil {
+		opt = &EditGroupClusterOptions{}
+	}
+	opt.PlatformKubernetes = &EditGroupPlatformKubernetesOptions{
+		APIURL: String(platform.apiURL),
+		Token:  String(platform.token),
+		CaCert: String(platform.caCert),
+	}
+
+	return s.EditCluster(gid, cluster, opt, options...)
+}
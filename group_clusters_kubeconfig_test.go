@@ -0,0 +1,157 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+const testKubeconfigNoCAData = `
+apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev-cluster
+  cluster:
+    server: https://example.com:6443
+contexts:
+- name: dev
+  context:
+    cluster: dev-cluster
+    user: dev-user
+users:
+- name: dev-user
+  user:
+    token: s3cr3t
+`
+
+const testKubeconfigWithToken = `
+apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev-cluster
+  cluster:
+    server: https://example.com:6443
+    certificate-authority-data: dGVzdC1jYS1kYXRh
+contexts:
+- name: dev
+  context:
+    cluster: dev-cluster
+    user: dev-user
+users:
+- name: dev-user
+  user:
+    token: s3cr3t
+`
+
+const testKubeconfigNoToken = `
+apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev-cluster
+  cluster:
+    server: https://example.com:6443
+    certificate-authority-data: dGVzdC1jYS1kYXRh
+contexts:
+- name: dev
+  context:
+    cluster: dev-cluster
+    user: dev-user
+users:
+- name: dev-user
+  user: {}
+`
+
+func TestAddClusterFromKubeconfig(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	gid := 1234
+
+	mux.HandleFunc("/api/v4/groups/1234/clusters/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":24,"name":"cluster-from-kubeconfig","platform_kubernetes":{"api_url":"https://example.com:6443","authorization_type":"rbac"}}`)
+	})
+
+	cluster, _, err := client.GroupClusters.AddClusterFromKubeconfig(gid, []byte(testKubeconfigWithToken), "", nil, nil)
+	if err != nil {
+		t.Fatalf("GroupClusters.AddClusterFromKubeconfig returned error: %v", err)
+	}
+
+	if cluster.ID != 24 {
+		t.Errorf("expected cluster ID 24; got %d", cluster.ID)
+	}
+}
+
+func TestAddClusterFromKubeconfigNoTokenSkipsServiceAccountCreation(t *testing.T) {
+	_, _, err := new(GroupClustersService).AddClusterFromKubeconfig(1234, []byte(testKubeconfigNoToken), "", nil, &AddClusterFromKubeconfigOptions{
+		SkipServiceAccountCreation: true,
+	})
+	if !errors.Is(err, ErrKubeconfigNoToken) {
+		t.Errorf("expected ErrKubeconfigNoToken; got %v", err)
+	}
+}
+
+func TestEditClusterFromKubeconfig(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	gid := 1234
+
+	mux.HandleFunc("/api/v4/groups/1234/clusters/24", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		fmt.Fprint(w, `{"id":24,"name":"cluster-from-kubeconfig","platform_kubernetes":{"api_url":"https://example.com:6443","authorization_type":"rbac"}}`)
+	})
+
+	cluster, _, err := client.GroupClusters.EditClusterFromKubeconfig(gid, 24, []byte(testKubeconfigWithToken), "", nil, nil)
+	if err != nil {
+		t.Fatalf("GroupClusters.EditClusterFromKubeconfig returned error: %v", err)
+	}
+
+	if cluster.ID != 24 {
+		t.Errorf("expected cluster ID 24; got %d", cluster.ID)
+	}
+}
+
+func TestResolveKubernetesPlatformContextNotFound(t *testing.T) {
+	_, err := resolveKubernetesPlatform([]byte(testKubeconfigWithToken), "does-not-exist", nil)
+	if !errors.Is(err, ErrKubeconfigContextNotFound) {
+		t.Errorf("expected ErrKubeconfigContextNotFound; got %v", err)
+	}
+}
+
+func TestResolveKubernetesPlatformNoCAData(t *testing.T) {
+	_, err := resolveKubernetesPlatform([]byte(testKubeconfigNoCAData), "", nil)
+	if !errors.Is(err, ErrKubeconfigNoCAData) {
+		t.Errorf("expected ErrKubeconfigNoCAData; got %v", err)
+	}
+}
+
+func TestResolveKubernetesPlatformUsesCurrentContextAndToken(t *testing.T) {
+	platform, err := resolveKubernetesPlatform([]byte(testKubeconfigWithToken), "", nil)
+	if err != nil {
+		t.Fatalf("resolveKubernetesPlatform returned error: %v", err)
+	}
+
+	if platform.apiURL != "https://example.com:6443" {
+		t.Errorf("expected api url https://example.com:6443; got %q", platform.apiURL)
+	}
+
+	if platform.token != "s3cr3t" {
+		t.Errorf("expected token s3cr3t; got %q", platform.token)
+	}
+
+	if platform.authorizationType != "rbac" {
+		t.Errorf("expected default authorization type rbac; got %q", platform.authorizationType)
+	}
+}
+
+func TestResolveKubernetesPlatformInvalidAuthorizationType(t *testing.T) {
+	_, err := resolveKubernetesPlatform([]byte(testKubeconfigWithToken), "", &AddClusterFromKubeconfigOptions{
+		AuthorizationType: "bogus",
+	})
+	if err == nil {
+		t.Error("expected an error for an invalid authorization type")
+	}
+}
@@ -1,4 +1,4 @@
-Grouppackage gitlab
+package gitlab
 
 import (
 	"fmt"
@@ -158,7 +158,7 @@ func TestAddGroupCluster(t *testing.T) {
 		fmt.Fprint(w, response)
 	})
 
-	cluster, _, err := client.GroupClusters.AddCluster(gid, &AddClusterOptions{})
+	cluster, _, err := client.GroupClusters.AddCluster(gid, &AddGroupClusterOptions{})
 
 	if err != nil {
 		t.Errorf("GroupClusters.AddCluster returned error: %v", err)
@@ -210,7 +210,7 @@ func TestEditGroupCluster(t *testing.T) {
 		fmt.Fprint(w, response)
 	})
 
-	cluster, _, err := client.GroupClusters.EditCluster(gid, 24, &EditClusterOptions{})
+	cluster, _, err := client.GroupClusters.EditCluster(gid, 24, &EditGroupClusterOptions{})
 
 	if err != nil {
 		t.Errorf("GroupClusters.EditCluster returned error: %v", err)
@@ -242,3 +242,79 @@ func TestDeleteGroupCluster(t *testing.T) {
 		t.Errorf("GroupClusters.DeleteCluster returned %d, want %d", got, want)
 	}
 }
+
+func TestListGroupClustersWithLabelSelector(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	gid := 1234
+
+	mux.HandleFunc("/api/v4/groups/1234/clusters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("label_selector"); got != "tier=prod,region!=eu" {
+			t.Errorf("expected label_selector query param %q; got %q", "tier=prod,region!=eu", got)
+		}
+		fmt.Fprint(w, `[
+			{"id":1,"name":"prod-us","labels":{"tier":"prod","region":"us"}},
+			{"id":2,"name":"prod-eu","labels":{"tier":"prod","region":"eu"}},
+			{"id":3,"name":"staging-us","labels":{"tier":"staging","region":"us"}}
+		]`)
+	})
+
+	clusters, _, err := client.GroupClusters.ListClustersWithOptions(gid, WithLabelSelector("tier=prod,region!=eu"))
+	if err != nil {
+		t.Fatalf("GroupClusters.ListClustersWithOptions returned error: %v", err)
+	}
+
+	if len(clusters) != 1 {
+		t.Fatalf("expected 1 cluster to match the selector; got %d", len(clusters))
+	}
+
+	if clusters[0].ID != 1 {
+		t.Errorf("expected cluster 1 to match; got %d", clusters[0].ID)
+	}
+}
+
+func TestListGroupClustersWithOptionsInvalidSelector(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+	gid := 1234
+
+	mux.HandleFunc("/api/v4/groups/1234/clusters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"name":"prod-us","labels":{"tier":"prod"}}]`)
+	})
+
+	_, _, err := client.GroupClusters.ListClustersWithOptions(gid, WithLabelSelector("not-a-valid-selector"))
+	if err == nil {
+		t.Fatal("expected an error for a malformed label selector, got nil")
+	}
+}
+
+func TestMatchLabels(t *testing.T) {
+	clusters := []*GroupCluster{
+		{ID: 1, Labels: map[string]string{"tier": "prod", "region": "eu"}},
+		{ID: 2, Labels: map[string]string{"tier": "staging", "region": "eu"}},
+	}
+
+	matched, err := MatchLabels(clusters, "tier=prod")
+	if err != nil {
+		t.Fatalf("MatchLabels returned error: %v", err)
+	}
+	if len(matched) != 1 || matched[0].ID != 1 {
+		t.Errorf("expected only cluster 1 to match; got %+v", matched)
+	}
+}
+
+func TestMatchLabelsInvalidSelector(t *testing.T) {
+	clusters := []*GroupCluster{
+		{ID: 1, Labels: map[string]string{"tier": "prod"}},
+	}
+
+	matched, err := MatchLabels(clusters, "not-a-valid-selector")
+	if err == nil {
+		t.Fatal("expected an error for a malformed label selector, got nil")
+	}
+	if matched != nil {
+		t.Errorf("expected no clusters returned alongside the error; got %+v", matched)
+	}
+}
@@ -0,0 +1,212 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListInstanceClusters(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/clusters", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		response := `[
+		{
+	    "id":18,
+	    "name":"cluster-1",
+	    "domain":"example.com",
+	    "created_at":"2019-01-02T20:18:12.563Z",
+	    "provider_type":"user",
+	    "platform_type":"kubernetes",
+	    "environment_scope":"*",
+	    "cluster_type":"instance_type",
+	    "user":
+	    {
+	      "id":1,
+	      "name":"Administrator",
+	      "username":"root",
+	      "state":"active",
+	      "avatar_url":"https://www.gravatar.com/avatar/4249f4df72b..",
+	      "web_url":"https://gitlab.example.com/root"
+	    },
+	    "platform_kubernetes":
+	    {
+	      "api_url":"https://104.197.68.152",
+	      "authorization_type":"rbac",
+	      "ca_cert":"-----BEGIN CERTIFICATE-----\r\nhFiK1L61owwDQYJKoZIhvcNAQELBQAw\r\n-----END CERTIFICATE-----"
+	    }
+	  }
+]`
+		fmt.Fprint(w, response)
+	})
+
+	clusters, _, err := client.InstanceClusters.ListClusters()
+
+	if err != nil {
+		t.Errorf("InstanceClusters.ListClusters returned error: %v", err)
+	}
+
+	if len(clusters) != 1 {
+		t.Errorf("expected 1 cluster; got %d", len(clusters))
+	}
+
+	if clusters[0].ID != 18 {
+		t.Errorf("expected clusterID 18; got %d", clusters[0].ID)
+	}
+
+	if clusters[0].Domain != "example.com" {
+		t.Errorf("expected cluster domain example.com; got %q", clusters[0].Domain)
+	}
+}
+
+func TestGetInstanceCluster(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/clusters/18", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		response := `{
+  "id":18,
+  "name":"cluster-1",
+  "domain":"example.com",
+  "created_at":"2019-01-02T20:18:12.563Z",
+  "provider_type":"user",
+  "platform_type":"kubernetes",
+  "environment_scope":"*",
+  "cluster_type":"instance_type",
+  "user":
+  {
+    "id":1,
+    "name":"Administrator",
+    "username":"root",
+    "state":"active",
+    "avatar_url":"https://www.gravatar.com/avatar/4249f4df72b..",
+    "web_url":"https://gitlab.example.com/root"
+  },
+  "platform_kubernetes":
+  {
+    "api_url":"https://104.197.68.152",
+    "authorization_type":"rbac",
+    "ca_cert":"-----BEGIN CERTIFICATE-----\r\nhFiK1L61owwDQYJKoZIhvcNAQELBQAw\r\n-----END CERTIFICATE-----"
+  }
+}`
+		fmt.Fprint(w, response)
+	})
+
+	cluster, _, err := client.InstanceClusters.GetCluster(18)
+
+	if err != nil {
+		t.Errorf("InstanceClusters.GetCluster returned error: %v", err)
+	}
+
+	if cluster.ID != 18 {
+		t.Errorf("expected clusterID 18; got %d", cluster.ID)
+	}
+
+	if cluster.Domain != "example.com" {
+		t.Errorf("expected cluster domain example.com; got %q", cluster.Domain)
+	}
+}
+
+func TestAddInstanceCluster(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/clusters/add", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		response := `{
+  "id":24,
+  "name":"cluster-5",
+  "created_at":"2019-01-03T21:53:40.610Z",
+  "provider_type":"user",
+  "platform_type":"kubernetes",
+  "environment_scope":"*",
+  "cluster_type":"instance_type",
+  "user":
+  {
+    "id":1,
+    "name":"Administrator",
+    "username":"root",
+    "state":"active",
+    "avatar_url":"https://www.gravatar.com/avatar/4249f4df72b..",
+    "web_url":"https://gitlab.example.com/root"
+  },
+  "platform_kubernetes":
+  {
+    "api_url":"https://35.111.51.20",
+    "authorization_type":"rbac",
+    "ca_cert":"-----BEGIN CERTIFICATE-----\r\nhFiK1L61owwDQYJKoZIhvcNAQELBQAw\r\n-----END CERTIFICATE-----"
+  }
+}`
+		fmt.Fprint(w, response)
+	})
+
+	cluster, _, err := client.InstanceClusters.AddCluster(&AddInstanceClusterOptions{})
+
+	if err != nil {
+		t.Errorf("InstanceClusters.AddCluster returned error: %v", err)
+	}
+
+	if cluster.ID != 24 {
+		t.Errorf("expected ClusterID 24; got %d", cluster.ID)
+	}
+}
+
+func TestEditInstanceCluster(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/clusters/24", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		response := `{
+  "id":24,
+  "name":"new-cluster-name",
+  "domain":"new-domain.com",
+  "created_at":"2019-01-03T21:53:40.610Z",
+  "provider_type":"user",
+  "platform_type":"kubernetes",
+  "environment_scope":"*",
+  "cluster_type":"instance_type",
+  "platform_kubernetes":
+  {
+    "api_url":"https://new-api-url.com",
+    "authorization_type":"rbac",
+    "ca_cert":null
+  }
+}`
+		fmt.Fprint(w, response)
+	})
+
+	cluster, _, err := client.InstanceClusters.EditCluster(24, &EditInstanceClusterOptions{})
+
+	if err != nil {
+		t.Errorf("InstanceClusters.EditCluster returned error: %v", err)
+	}
+
+	if cluster.ID != 24 {
+		t.Errorf("expected ClusterID 24; got %d", cluster.ID)
+	}
+}
+
+func TestDeleteInstanceCluster(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/clusters/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	resp, err := client.InstanceClusters.DeleteCluster(1)
+	if err != nil {
+		t.Errorf("InstanceClusters.DeleteCluster returned error: %v", err)
+	}
+
+	want := http.StatusAccepted
+	got := resp.StatusCode
+	if got != want {
+		t.Errorf("InstanceClusters.DeleteCluster returned %d, want %d", got, want)
+	}
+}
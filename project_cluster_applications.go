@@ -0,0 +1,91 @@
+package gitlab
+
+import (
+	"fmt"
+)
+
+// ListApplications returns the install status of every application GitLab
+// knows about for the given project cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_clusters.html#get-a-single-project-cluster
+func (s *ProjectClustersService) ListApplications(pid interface{}, cluster int, options ...OptionFunc) ([]*ClusterApplication, *Response, error) {
+	pc, resp, err := s.GetCluster(pid, cluster, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pc.Applications, resp, nil
+}
+
+// InstallApplication schedules the named application for install on the
+// given project cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_clusters.html
+func (s *ProjectClustersService) InstallApplication(pid interface{}, cluster int, application string, opt *InstallApplicationOptions, options ...OptionFunc) (*ClusterApplication, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/clusters/%d/applications/%s", pathEscape(project), cluster, application)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := new(ClusterApplication)
+	resp, err := s.client.Do(req, app)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return app, resp, err
+}
+
+// UpdateApplication updates the named application on the given project
+// cluster, for example to roll it onto a new chart version.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_clusters.html
+func (s *ProjectClustersService) UpdateApplication(pid interface{}, cluster int, application string, opt *InstallApplicationOptions, options ...OptionFunc) (*ClusterApplication, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/clusters/%d/applications/%s", pathEscape(project), cluster, application)
+
+	req, err := s.client.NewRequest("PATCH", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := new(ClusterApplication)
+	resp, err := s.client.Do(req, app)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return app, resp, err
+}
+
+// UninstallApplication schedules the named application for removal from the
+// given project cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_clusters.html
+func (s *ProjectClustersService) UninstallApplication(pid interface{}, cluster int, application string, options ...OptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/clusters/%d/applications/%s", pathEscape(project), cluster, application)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,90 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListProjectClusterApplications(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1234/clusters/18", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":18,"name":"cluster-1","applications":[{"name":"helm","status":"installed"},{"name":"ingress","status":"installable"}]}`)
+	})
+
+	apps, _, err := client.ProjectClusters.ListApplications(1234, 18)
+	if err != nil {
+		t.Fatalf("ProjectClusters.ListApplications returned error: %v", err)
+	}
+
+	if len(apps) != 2 {
+		t.Fatalf("expected 2 applications; got %d", len(apps))
+	}
+
+	if apps[0].Name != "helm" || apps[0].Status != ClusterApplicationInstalled {
+		t.Errorf("unexpected first application: %+v", apps[0])
+	}
+}
+
+func TestInstallProjectClusterApplication(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1234/clusters/18/applications/cert-manager", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"name":"cert-manager","status":"scheduled"}`)
+	})
+
+	app, _, err := client.ProjectClusters.InstallApplication(1234, 18, "cert-manager", &InstallApplicationOptions{
+		Email: String("ops@example.com"),
+	})
+	if err != nil {
+		t.Fatalf("ProjectClusters.InstallApplication returned error: %v", err)
+	}
+
+	if app.Status != ClusterApplicationScheduled {
+		t.Errorf("expected status scheduled; got %q", app.Status)
+	}
+}
+
+func TestUpdateProjectClusterApplication(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1234/clusters/18/applications/ingress", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"name":"ingress","status":"updating"}`)
+	})
+
+	app, _, err := client.ProjectClusters.UpdateApplication(1234, 18, "ingress", &InstallApplicationOptions{
+		ModsecurityEnabled: Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("ProjectClusters.UpdateApplication returned error: %v", err)
+	}
+
+	if app.Status != ClusterApplicationUpdating {
+		t.Errorf("expected status updating; got %q", app.Status)
+	}
+}
+
+func TestUninstallProjectClusterApplication(t *testing.T) {
+	mux, server, client := setup()
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1234/clusters/18/applications/helm", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	resp, err := client.ProjectClusters.UninstallApplication(1234, 18, "helm")
+	if err != nil {
+		t.Fatalf("ProjectClusters.UninstallApplication returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("expected status 202; got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,203 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProjectClustersService handles communication with the
+// project clusters related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_clusters.html
+type ProjectClustersService struct {
+	client *Client
+}
+
+// ProjectCluster represents a GitLab Project Cluster.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_clusters.html
+type ProjectCluster struct {
+	ID                 int                        `json:"id"`
+	Name               string                     `json:"name"`
+	Domain             string                     `json:"domain"`
+	CreatedAt          *time.Time                 `json:"created_at"`
+	ProviderType       string                     `json:"provider_type"`
+	PlatformType       string                     `json:"platform_type"`
+	EnvironmentScope   string                     `json:"environment_scope"`
+	ClusterType        string                     `json:"cluster_type"`
+	User               *User                      `json:"user"`
+	PlatformKubernetes *ProjectPlatformKubernetes `json:"platform_kubernetes"`
+	Project            *Project                   `json:"project"`
+	Applications       []*ClusterApplication      `json:"applications"`
+}
+
+// ProjectPlatformKubernetes represents a GitLab Project Cluster PlatformKubernetes.
+type ProjectPlatformKubernetes struct {
+	APIURL            string `json:"api_url"`
+	Token             string `json:"token"`
+	CaCert            string `json:"ca_cert"`
+	AuthorizationType string `json:"authorization_type"`
+}
+
+func (p ProjectCluster) String() string {
+	return Stringify(p)
+}
+
+// ListClusters gets a list of all clusters in a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_clusters.html#list-project-clusters
+func (s *ProjectClustersService) ListClusters(pid interface{}, options ...OptionFunc) ([]*ProjectCluster, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/clusters", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pcs []*ProjectCluster
+	resp, err := s.client.Do(req, &pcs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pcs, resp, err
+}
+
+// GetCluster gets a cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_clusters.html#get-a-single-project-cluster
+func (s *ProjectClustersService) GetCluster(pid interface{}, cluster int, options ...OptionFunc) (*ProjectCluster, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/clusters/%d", pathEscape(project), cluster)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pc := new(ProjectCluster)
+	resp, err := s.client.Do(req, &pc)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pc, resp, err
+}
+
+// AddProjectClusterOptions represents the available AddCluster() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_clusters.html#add-existing-cluster-to-project
+type AddProjectClusterOptions struct {
+	Name               *string                              `url:"name,omitempty" json:"name,omitempty"`
+	Domain             *string                              `url:"domain,omitempty" json:"domain,omitempty"`
+	Enabled            *bool                                `url:"enabled,omitempty" json:"enabled,omitempty"`
+	Managed            *bool                                `url:"managed,omitempty" json:"managed,omitempty"`
+	EnvironmentScope   *string                              `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
+	PlatformKubernetes *AddProjectPlatformKubernetesOptions `url:"platform_kubernetes_attributes,omitempty" json:"platform_kubernetes_attributes,omitempty"`
+}
+
+// AddProjectPlatformKubernetesOptions represents the available PlatformKubernetes options for adding a Project Cluster.
+type AddProjectPlatformKubernetesOptions struct {
+	APIURL            *string `url:"api_url,omitempty" json:"api_url,omitempty"`
+	Token             *string `url:"token,omitempty" json:"token,omitempty"`
+	CaCert            *string `url:"ca_cert,omitempty" json:"ca_cert,omitempty"`
+	AuthorizationType *string `url:"authorization_type,omitempty" json:"authorization_type,omitempty"`
+}
+
+// AddCluster adds an existing cluster to the project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_clusters.html#add-existing-cluster-to-project
+func (s *ProjectClustersService) AddCluster(pid interface{}, opt *AddProjectClusterOptions, options ...OptionFunc) (*ProjectCluster, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/clusters/user", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pc := new(ProjectCluster)
+	resp, err := s.client.Do(req, pc)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pc, resp, err
+}
+
+// EditProjectClusterOptions represents the available EditCluster() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_clusters.html#edit-project-cluster
+type EditProjectClusterOptions struct {
+	Name               *string                               `url:"name,omitempty" json:"name,omitempty"`
+	Domain             *string                               `url:"domain,omitempty" json:"domain,omitempty"`
+	EnvironmentScope   *string                               `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
+	PlatformKubernetes *EditProjectPlatformKubernetesOptions `url:"platform_kubernetes_attributes,omitempty" json:"platform_kubernetes_attributes,omitempty"`
+}
+
+// EditProjectPlatformKubernetesOptions represents the available PlatformKubernetes options for editing a Project Cluster.
+type EditProjectPlatformKubernetesOptions struct {
+	APIURL *string `url:"api_url,omitempty" json:"api_url,omitempty"`
+	Token  *string `url:"token,omitempty" json:"token,omitempty"`
+	CaCert *string `url:"ca_cert,omitempty" json:"ca_cert,omitempty"`
+}
+
+// EditCluster updates an existing project cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_clusters.html#edit-project-cluster
+func (s *ProjectClustersService) EditCluster(pid interface{}, cluster int, opt *EditProjectClusterOptions, options ...OptionFunc) (*ProjectCluster, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/clusters/%d", pathEscape(project), cluster)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pc := new(ProjectCluster)
+	resp, err := s.client.Do(req, pc)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pc, resp, err
+}
+
+// DeleteCluster deletes an existing project cluster.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_clusters.html#delete-project-cluster
+func (s *ProjectClustersService) DeleteCluster(pid interface{}, cluster int, options ...OptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/clusters/%d", pathEscape(project), cluster)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}